@@ -0,0 +1,75 @@
+package yfusion
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// TermInfo - A suggested search term returned from the Autocomplete route
+type TermInfo struct {
+	Text string
+}
+
+// AutocompleteData - The data returned from the Autocomplete route
+type AutocompleteData struct {
+	Terms      []TermInfo
+	Businesses []GeneralBusinessInfo
+	Categories []CategoriesInfo
+}
+
+// AutocompleteParams - Options to use when sending a request to the Autocomplete route.
+// Text is mandatory. All other fields are optional.
+type AutocompleteParams struct {
+	Text      *string
+	Latitude  *float64
+	Longitude *float64
+	Locale    *string
+}
+
+// Params - Return the set AutocompleteParams fields in a query param string
+func (ap *AutocompleteParams) Params() (string, error) {
+	if ap.Text == nil {
+		return "", errors.New("error missing required field: Text")
+	}
+	sb := &strings.Builder{}
+	sb.WriteString(fmt.Sprintf("text=%s", url.QueryEscape(*ap.Text)))
+	if ap.Latitude != nil {
+		sb.WriteString("&")
+		sb.WriteString(fmt.Sprintf("latitude=%f", *ap.Latitude))
+	}
+	if ap.Longitude != nil {
+		sb.WriteString("&")
+		sb.WriteString(fmt.Sprintf("longitude=%f", *ap.Longitude))
+	}
+	if ap.Locale != nil {
+		sb.WriteString("&")
+		sb.WriteString(fmt.Sprintf("locale=%s", url.QueryEscape(*ap.Locale)))
+	}
+	return sb.String(), nil
+}
+
+// SetText - Set the text to get suggestions for
+func (ap *AutocompleteParams) SetText(s string) {
+	ap.Text = new(string)
+	*ap.Text = s
+}
+
+// SetLatitude - Set the latitude to bias suggestions towards
+func (ap *AutocompleteParams) SetLatitude(i float64) {
+	ap.Latitude = new(float64)
+	*ap.Latitude = i
+}
+
+// SetLongitude - Set the longitude to bias suggestions towards
+func (ap *AutocompleteParams) SetLongitude(i float64) {
+	ap.Longitude = new(float64)
+	*ap.Longitude = i
+}
+
+// SetLocale - Set the locale
+func (ap *AutocompleteParams) SetLocale(s string) {
+	ap.Locale = new(string)
+	*ap.Locale = s
+}
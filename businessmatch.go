@@ -0,0 +1,162 @@
+package yfusion
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// BusinessMatchData - The data returned from the Business Match route
+type BusinessMatchData struct {
+	Businesses []GeneralBusinessInfo
+}
+
+// BusinessMatchParams - Options to use when sending a request to the Business Match route.
+// Name, Address1, City, State, and Country are mandatory. All other fields are optional.
+type BusinessMatchParams struct {
+	Name           *string
+	Address1       *string
+	Address2       *string
+	Address3       *string
+	City           *string
+	State          *string
+	Country        *string
+	Latitude       *float64
+	Longitude      *float64
+	Phone          *string
+	ZipCode        *string
+	YelpBusinessID *string
+	Limit          *int
+	MatchThreshold *string
+}
+
+// Params - Return the set BusinessMatchParams fields in a query param string
+func (bm *BusinessMatchParams) Params() (string, error) {
+	if bm.Name == nil || bm.Address1 == nil || bm.City == nil || bm.State == nil || bm.Country == nil {
+		return "", errors.New("error missing required fields: Name, Address1, City, State, and Country")
+	}
+	sb := &strings.Builder{}
+	sb.WriteString(fmt.Sprintf("name=%s", url.QueryEscape(*bm.Name)))
+	sb.WriteString(fmt.Sprintf("&address1=%s", url.QueryEscape(*bm.Address1)))
+	sb.WriteString(fmt.Sprintf("&city=%s", url.QueryEscape(*bm.City)))
+	sb.WriteString(fmt.Sprintf("&state=%s", url.QueryEscape(*bm.State)))
+	sb.WriteString(fmt.Sprintf("&country=%s", url.QueryEscape(*bm.Country)))
+	if bm.Address2 != nil {
+		sb.WriteString(fmt.Sprintf("&address2=%s", url.QueryEscape(*bm.Address2)))
+	}
+	if bm.Address3 != nil {
+		sb.WriteString(fmt.Sprintf("&address3=%s", url.QueryEscape(*bm.Address3)))
+	}
+	if bm.Latitude != nil {
+		sb.WriteString(fmt.Sprintf("&latitude=%f", *bm.Latitude))
+	}
+	if bm.Longitude != nil {
+		sb.WriteString(fmt.Sprintf("&longitude=%f", *bm.Longitude))
+	}
+	if bm.Phone != nil {
+		sb.WriteString(fmt.Sprintf("&phone=%s", url.QueryEscape(*bm.Phone)))
+	}
+	if bm.ZipCode != nil {
+		sb.WriteString(fmt.Sprintf("&zip_code=%s", url.QueryEscape(*bm.ZipCode)))
+	}
+	if bm.YelpBusinessID != nil {
+		sb.WriteString(fmt.Sprintf("&yelp_business_id=%s", url.QueryEscape(*bm.YelpBusinessID)))
+	}
+	if bm.Limit != nil {
+		sb.WriteString(fmt.Sprintf("&limit=%d", *bm.Limit))
+	}
+	if bm.MatchThreshold != nil {
+		sb.WriteString(fmt.Sprintf("&match_threshold=%s", url.QueryEscape(*bm.MatchThreshold)))
+	}
+	return sb.String(), nil
+}
+
+// SetName - Set the business name to match on
+func (bm *BusinessMatchParams) SetName(s string) {
+	bm.Name = new(string)
+	*bm.Name = s
+}
+
+// SetAddress1 - Set the first line of the business address
+func (bm *BusinessMatchParams) SetAddress1(s string) {
+	bm.Address1 = new(string)
+	*bm.Address1 = s
+}
+
+// SetAddress2 - Set the second line of the business address
+func (bm *BusinessMatchParams) SetAddress2(s string) {
+	bm.Address2 = new(string)
+	*bm.Address2 = s
+}
+
+// SetAddress3 - Set the third line of the business address
+func (bm *BusinessMatchParams) SetAddress3(s string) {
+	bm.Address3 = new(string)
+	*bm.Address3 = s
+}
+
+// SetCity - Set the business city
+func (bm *BusinessMatchParams) SetCity(s string) {
+	bm.City = new(string)
+	*bm.City = s
+}
+
+// SetState - Set the business state code
+func (bm *BusinessMatchParams) SetState(s string) {
+	bm.State = new(string)
+	*bm.State = s
+}
+
+// SetCountry - Set the business country code
+func (bm *BusinessMatchParams) SetCountry(s string) {
+	bm.Country = new(string)
+	*bm.Country = s
+}
+
+// SetLatitude - Set the latitude to help disambiguate the match
+func (bm *BusinessMatchParams) SetLatitude(i float64) {
+	bm.Latitude = new(float64)
+	*bm.Latitude = i
+}
+
+// SetLongitude - Set the longitude to help disambiguate the match
+func (bm *BusinessMatchParams) SetLongitude(i float64) {
+	bm.Longitude = new(float64)
+	*bm.Longitude = i
+}
+
+// SetPhone - Set the business phone number
+func (bm *BusinessMatchParams) SetPhone(s string) {
+	bm.Phone = new(string)
+	*bm.Phone = s
+}
+
+// SetZipCode - Set the business zip code
+func (bm *BusinessMatchParams) SetZipCode(s string) {
+	bm.ZipCode = new(string)
+	*bm.ZipCode = s
+}
+
+// SetYelpBusinessID - Set a Yelp business ID to directly compare against
+func (bm *BusinessMatchParams) SetYelpBusinessID(s string) {
+	bm.YelpBusinessID = new(string)
+	*bm.YelpBusinessID = s
+}
+
+// SetLimit - Set the number of matched businesses to return
+func (bm *BusinessMatchParams) SetLimit(i int) {
+	bm.Limit = new(int)
+	*bm.Limit = i
+}
+
+// SetMatchThreshold - Set how strict the matching should be
+//
+// options:
+// - none
+// - default
+// - strict
+func (bm *BusinessMatchParams) SetMatchThreshold(s string) {
+	bm.MatchThreshold = new(string)
+	*bm.MatchThreshold = s
+}
@@ -0,0 +1,131 @@
+package yfusion
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// YelpAPIError - Represents an error response returned by the Yelp Fusion API.
+// StatusCode is the HTTP status code of the response. Code and Description are
+// populated from Yelp's JSON error body when present. RateLimitRemaining,
+// RateLimitResetTime, and RetryAfter mirror the response headers of the same name.
+type YelpAPIError struct {
+	StatusCode         int
+	Code               string
+	Description        string
+	RateLimitRemaining string
+	RateLimitResetTime string
+	RetryAfter         string
+}
+
+// Error - Satisfies the error interface
+func (e *YelpAPIError) Error() string {
+	if e.Description != "" {
+		return fmt.Sprintf("yfusion: %d %s: %s", e.StatusCode, e.Code, e.Description)
+	}
+	return fmt.Sprintf("yfusion: unexpected status %d", e.StatusCode)
+}
+
+type yelpErrorBody struct {
+	Error struct {
+		Code        string `json:"code"`
+		Description string `json:"description"`
+	} `json:"error"`
+}
+
+// newYelpAPIError - Build a YelpAPIError from a non-2xx http.Response, consuming and closing its body
+func newYelpAPIError(resp *http.Response) *YelpAPIError {
+	defer resp.Body.Close()
+	apiErr := &YelpAPIError{
+		StatusCode:         resp.StatusCode,
+		RateLimitRemaining: resp.Header.Get("RateLimit-Remaining"),
+		RateLimitResetTime: resp.Header.Get("RateLimit-ResetTime"),
+		RetryAfter:         resp.Header.Get("Retry-After"),
+	}
+	var body yelpErrorBody
+	if err := json.NewDecoder(resp.Body).Decode(&body); err == nil {
+		apiErr.Code = body.Error.Code
+		apiErr.Description = body.Error.Description
+	}
+	return apiErr
+}
+
+// RetryPolicy - Configures automatic retries for 429 and 5xx responses using exponential backoff.
+// Retry-After is honored when present on a 429 response.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of times a request is sent, including the first try.
+	// Values <= 0 are treated as 1, i.e. the request is sent once with no retries.
+	MaxAttempts int
+	BaseDelay   time.Duration
+	MaxDelay    time.Duration
+}
+
+// DefaultRetryPolicy - A sensible default: 3 attempts, 500ms base delay, 10s max delay
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts: 3,
+	BaseDelay:   500 * time.Millisecond,
+	MaxDelay:    10 * time.Second,
+}
+
+func (p *RetryPolicy) backoff(attempt int, retryAfter string) time.Duration {
+	if retryAfter != "" {
+		if secs, err := strconv.Atoi(retryAfter); err == nil {
+			return time.Duration(secs) * time.Second
+		}
+	}
+	delay := p.BaseDelay * time.Duration(math.Pow(2, float64(attempt)))
+	if delay > p.MaxDelay {
+		delay = p.MaxDelay
+	}
+	return delay
+}
+
+func shouldRetry(statusCode int) bool {
+	return statusCode == http.StatusTooManyRequests || statusCode >= http.StatusInternalServerError
+}
+
+// do - Wait for the configured rate limiter (if any), then dispatch req, retrying 429 and 5xx
+// responses according to yf.retry if one is configured
+func (yf *YelpFusion) do(ctx context.Context, req *http.Request) (*http.Response, error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if yf.limiter != nil {
+		if err := yf.limiter.Wait(ctx); err != nil {
+			return nil, err
+		}
+	}
+	if yf.retry == nil {
+		return yf.client.Do(req)
+	}
+	maxAttempts := yf.retry.MaxAttempts
+	if maxAttempts <= 0 {
+		maxAttempts = 1
+	}
+	var resp *http.Response
+	var err error
+	for attempt := 0; attempt < maxAttempts; attempt++ {
+		resp, err = yf.client.Do(req)
+		if err != nil {
+			return nil, err
+		}
+		if !shouldRetry(resp.StatusCode) || attempt == maxAttempts-1 {
+			return resp, nil
+		}
+		retryAfter := resp.Header.Get("Retry-After")
+		resp.Body.Close()
+		timer := time.NewTimer(yf.retry.backoff(attempt, retryAfter))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+	return resp, err
+}
@@ -0,0 +1,149 @@
+package yfusion
+
+import "context"
+
+// maxBusinessSearchResults - Yelp Fusion caps Business Search results at offset+limit <= 1000
+const maxBusinessSearchResults = 1000
+
+// defaultIteratorLimit - Page size used by BusinessIterator when params.Limit is unset
+const defaultIteratorLimit = 50
+
+// BusinessIterator - Iterates over all businesses matching a BusinessSearchParams query,
+// transparently issuing follow-up requests as each page is exhausted. Iteration starts at
+// offset 0 and stops after maxBusinessSearchResults (1000) results, even when Total is larger.
+//
+// Construct one with IterateBusinesses. Call Next until it returns false, reading Business
+// after each true result, then check Err to distinguish end-of-results from a request failure.
+type BusinessIterator struct {
+	yf      *YelpFusion
+	ctx     context.Context
+	params  BusinessSearchParams
+	page    []GeneralBusinessInfo
+	idx     int
+	offset  int
+	total   int
+	fetched bool
+	err     error
+}
+
+// IterateBusinesses - Create a BusinessIterator over all businesses matching the given
+// BusinessSearchParams, honoring the rate limiter and retry policy configured on yf.
+func (yf *YelpFusion) IterateBusinesses(ctx context.Context, params *BusinessSearchParams) *BusinessIterator {
+	return &BusinessIterator{yf: yf, ctx: ctx, params: *params}
+}
+
+// Next - Advance to the next business, fetching another page of results if needed.
+// Returns false once every business has been visited, the 1000-result cap is reached, or a
+// request fails.
+func (it *BusinessIterator) Next() bool {
+	if it.err != nil {
+		return false
+	}
+	if it.idx < len(it.page) {
+		it.idx++
+		return true
+	}
+	if it.fetched && it.offset >= it.total {
+		return false
+	}
+	if it.fetched && it.offset >= maxBusinessSearchResults {
+		return false
+	}
+	if err := it.fetchPage(); err != nil {
+		it.err = err
+		return false
+	}
+	if len(it.page) == 0 {
+		return false
+	}
+	it.idx = 1
+	return true
+}
+
+func (it *BusinessIterator) fetchPage() error {
+	p := it.params
+	limit := defaultIteratorLimit
+	if p.Limit != nil {
+		limit = *p.Limit
+	}
+	if remaining := maxBusinessSearchResults - it.offset; remaining < limit {
+		limit = remaining
+	}
+	p.SetOffset(it.offset)
+	p.SetLimit(limit)
+	data, err := it.yf.SearchBusinessWithContext(it.ctx, &p)
+	if err != nil {
+		return err
+	}
+	it.page = data.Businesses
+	it.total = data.Total
+	it.offset += len(data.Businesses)
+	it.fetched = true
+	it.idx = 0
+	return nil
+}
+
+// Business - The business Next most recently advanced to
+func (it *BusinessIterator) Business() GeneralBusinessInfo {
+	return it.page[it.idx-1]
+}
+
+// Err - Non-nil if a request made while iterating failed
+func (it *BusinessIterator) Err() error {
+	return it.err
+}
+
+// ReviewIterator - Iterates over the reviews for a business.
+//
+// Yelp Fusion's Business Reviews route does not support offset/limit pagination, so this
+// wraps the single page the API returns, giving callers the same Next/Err shape as
+// BusinessIterator.
+type ReviewIterator struct {
+	yf      *YelpFusion
+	ctx     context.Context
+	busID   string
+	locale  string
+	reviews []*ReviewInfo
+	idx     int
+	fetched bool
+	err     error
+}
+
+// IterateReviews - Create a ReviewIterator over the reviews for the given business ID.
+//
+// The locale defaults to en_US if left blank.
+func (yf *YelpFusion) IterateReviews(ctx context.Context, busID, locale string) *ReviewIterator {
+	return &ReviewIterator{yf: yf, ctx: ctx, busID: busID, locale: locale}
+}
+
+// Next - Advance to the next review. Returns false once every review has been visited or the
+// request fails.
+func (it *ReviewIterator) Next() bool {
+	if it.err != nil {
+		return false
+	}
+	if !it.fetched {
+		data, err := it.yf.SearchBusinessReviewsWithLocale(it.ctx, it.busID, it.locale)
+		if err != nil {
+			it.err = err
+			return false
+		}
+		it.reviews = data.Reviews
+		it.fetched = true
+	}
+	if it.idx >= len(it.reviews) {
+		return false
+	}
+	it.idx++
+	return true
+}
+
+// Review - The review Next most recently advanced to
+func (it *ReviewIterator) Review() *ReviewInfo {
+	return it.reviews[it.idx-1]
+}
+
+// Err - Non-nil if the request made while iterating failed
+func (it *ReviewIterator) Err() error {
+	return it.err
+}
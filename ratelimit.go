@@ -0,0 +1,74 @@
+package yfusion
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// RateLimiter - A thread-safe token-bucket rate limiter with no external dependencies.
+// Tokens refill continuously at rps tokens per second up to burst, the maximum burst size,
+// both as given to NewRateLimiter.
+type RateLimiter struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+// NewRateLimiter - Create a RateLimiter allowing up to rps requests per second on average,
+// with bursts of up to burst requests.
+func NewRateLimiter(rps float64, burst int) *RateLimiter {
+	return &RateLimiter{
+		rate:   rps,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   time.Now(),
+	}
+}
+
+// Wait - Block until a token is available or ctx is done, whichever comes first.
+// The limiter's state is shared across goroutines, so a single RateLimiter can be
+// reused across concurrent callers of the same YelpFusion.
+func (r *RateLimiter) Wait(ctx context.Context) error {
+	for {
+		wait := r.reserve()
+		if wait <= 0 {
+			return nil
+		}
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// reserve - Refill tokens based on elapsed time and consume one if available.
+// Returns zero if a token was consumed, otherwise the duration to wait before retrying.
+func (r *RateLimiter) reserve() time.Duration {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	now := time.Now()
+	r.tokens += now.Sub(r.last).Seconds() * r.rate
+	if r.tokens > r.burst {
+		r.tokens = r.burst
+	}
+	r.last = now
+	if r.tokens >= 1 {
+		r.tokens--
+		return 0
+	}
+	return time.Duration((1 - r.tokens) / r.rate * float64(time.Second))
+}
+
+// WithRateLimiter - Attach a RateLimiter that every request method will wait on before
+// dispatching a request.
+func WithRateLimiter(limiter *RateLimiter) Option {
+	return func(yf *YelpFusion) {
+		yf.limiter = limiter
+	}
+}
@@ -0,0 +1,38 @@
+package yfusion
+
+// TransactionSearchParams - Options to use when sending a request to the Transaction Search route.
+// Location is Mandatory if Latitude and Longitude are not specified.
+// Latitude and Longitude are required if Location is not specified.
+type TransactionSearchParams struct {
+	Location  *string
+	Latitude  *float64
+	Longitude *float64
+}
+
+// Params - Return the set TransactionSearchParams fields in a query param string
+func (ts *TransactionSearchParams) Params() (string, error) {
+	bus := &BusinessSearchParams{
+		Location:  ts.Location,
+		Latitude:  ts.Latitude,
+		Longitude: ts.Longitude,
+	}
+	return getLocOrLatLong(bus)
+}
+
+// SetLocation - Set the location to focus on
+func (ts *TransactionSearchParams) SetLocation(s string) {
+	ts.Location = new(string)
+	*ts.Location = s
+}
+
+// SetLatitude - Set the latitude to query for
+func (ts *TransactionSearchParams) SetLatitude(i float64) {
+	ts.Latitude = new(float64)
+	*ts.Latitude = i
+}
+
+// SetLongitude - Set the longitude to query for
+func (ts *TransactionSearchParams) SetLongitude(i float64) {
+	ts.Longitude = new(float64)
+	*ts.Longitude = i
+}
@@ -11,36 +11,74 @@ import (
 )
 
 const (
-	baseURL      = "https://api.yelp.com/v3"
-	busDetails   = "/businesses"
-	busSearch    = busDetails + "/search"
-	phoneSearch  = busSearch + "/phone"
-	reviewSearch = "/reviews"
+	defaultBaseURL = "https://api.yelp.com/v3"
+	busDetails     = "/businesses"
+	busSearch      = busDetails + "/search"
+	phoneSearch    = busSearch + "/phone"
+	reviewSearch   = "/reviews"
+	autocompleteEP = "/autocomplete"
+	transactionsEP = "/transactions"
+	busMatches     = busDetails + "/matches"
 )
 
 // YelpFusion - Object to interact with Yelp's Fusion v3 API
 type YelpFusion struct {
-	client *http.Client
-	apiKey string
+	client    *http.Client
+	apiKey    string
+	baseURL   string
+	userAgent string
+	retry     *RetryPolicy
+	limiter   *RateLimiter
 }
 
-// NewYelpFusion - Generate a new YelpFusion object with a given API key
-func NewYelpFusion(key string) *YelpFusion {
-	return &YelpFusion{
-		client: http.DefaultClient,
-		apiKey: key,
+// Option - Configures a YelpFusion object when passed to NewYelpFusion
+type Option func(*YelpFusion)
+
+// WithHTTPClient - Use the given http.Client to perform requests
+func WithHTTPClient(client *http.Client) Option {
+	return func(yf *YelpFusion) {
+		if client != nil {
+			yf.client = client
+		}
+	}
+}
+
+// WithBaseURL - Override the Yelp Fusion base URL.
+//
+// Useful for pointing at a mock server or sandbox during testing.
+func WithBaseURL(url string) Option {
+	return func(yf *YelpFusion) {
+		if url != "" {
+			yf.baseURL = url
+		}
+	}
+}
+
+// WithUserAgent - Set the User-Agent header sent with every request
+func WithUserAgent(userAgent string) Option {
+	return func(yf *YelpFusion) {
+		yf.userAgent = userAgent
+	}
+}
+
+// WithRetry - Automatically retry 429 and 5xx responses according to the given RetryPolicy
+func WithRetry(policy RetryPolicy) Option {
+	return func(yf *YelpFusion) {
+		yf.retry = &policy
 	}
 }
 
-// NewYelpFusionWithClient - Generate a new YelpFusion object with a given API key and http client object
-func NewYelpFusionWithClient(key string, client *http.Client) *YelpFusion {
-	if client == nil {
-		client = http.DefaultClient
+// NewYelpFusion - Generate a new YelpFusion object with a given API key and optional Options
+func NewYelpFusion(key string, opts ...Option) *YelpFusion {
+	yf := &YelpFusion{
+		client:  http.DefaultClient,
+		apiKey:  key,
+		baseURL: defaultBaseURL,
 	}
-	return &YelpFusion{
-		client: client,
-		apiKey: key,
+	for _, opt := range opts {
+		opt(yf)
 	}
+	return yf
 }
 
 func (yf *YelpFusion) getRequest(ctx context.Context, method, url string) (*http.Request, error) {
@@ -49,8 +87,11 @@ func (yf *YelpFusion) getRequest(ctx context.Context, method, url string) (*http
 		return nil, err
 	}
 	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", yf.apiKey))
+	if yf.userAgent != "" {
+		req.Header.Set("User-Agent", yf.userAgent)
+	}
 	if ctx != nil {
-		req.WithContext(ctx)
+		req = req.WithContext(ctx)
 	}
 	return req, nil
 }
@@ -69,7 +110,7 @@ func (yf *YelpFusion) SearchBusinessWithContext(ctx context.Context, bus *Busine
 		return nil, err
 	}
 	if resp.StatusCode != http.StatusOK {
-		return nil, errors.New(resp.Status)
+		return nil, newYelpAPIError(resp)
 	}
 	defer resp.Body.Close()
 	var b *BusinessSearchData
@@ -87,12 +128,12 @@ func (yf *YelpFusion) SearchBusinessResponse(ctx context.Context, bus *BusinessS
 	if err != nil {
 		return nil, err
 	}
-	urlStr := fmt.Sprintf("%s%s?%s", baseURL, busSearch, params)
+	urlStr := fmt.Sprintf("%s%s?%s", yf.baseURL, busSearch, params)
 	req, err := yf.getRequest(ctx, "GET", urlStr)
 	if err != nil {
 		return nil, err
 	}
-	return yf.client.Do(req)
+	return yf.do(ctx, req)
 }
 
 // SearchBusinessDetails - Query details about a business, given its ID
@@ -111,7 +152,7 @@ func (yf *YelpFusion) SearchBusinessDetailsWithLocale(ctx context.Context, busID
 		return nil, err
 	}
 	if resp.StatusCode != http.StatusOK {
-		return nil, errors.New(resp.Status)
+		return nil, newYelpAPIError(resp)
 	}
 	defer resp.Body.Close()
 	var b *DetailedBusinessInfo
@@ -127,7 +168,7 @@ func (yf *YelpFusion) SearchBusinessDetailsWithLocale(ctx context.Context, busID
 // With the option of specifing a locale. (An empty string for locale will leave the parameter off)
 // returns the Response from the request
 func (yf *YelpFusion) SearchBusinessDetailsWithLocaleResponse(ctx context.Context, busID, locale string) (*http.Response, error) {
-	urlStr := fmt.Sprintf("%s%s/%s", baseURL, busDetails, busID)
+	urlStr := fmt.Sprintf("%s%s/%s", yf.baseURL, busDetails, busID)
 	if strings.TrimSpace(locale) != "" {
 		urlStr = fmt.Sprintf("%s?locale=%s", urlStr, url.QueryEscape(locale))
 	}
@@ -135,7 +176,7 @@ func (yf *YelpFusion) SearchBusinessDetailsWithLocaleResponse(ctx context.Contex
 	if err != nil {
 		return nil, err
 	}
-	return yf.client.Do(req)
+	return yf.do(ctx, req)
 }
 
 // SearchBusinessesByPhone - Query Businesses by a phone number.
@@ -154,7 +195,7 @@ func (yf *YelpFusion) SearchBusinessesByPhoneWithContext(ctx context.Context, ph
 		return nil, err
 	}
 	if resp.StatusCode != http.StatusOK {
-		return nil, errors.New(resp.Status)
+		return nil, newYelpAPIError(resp)
 	}
 	defer resp.Body.Close()
 	var b *BusinessSearchData
@@ -173,12 +214,12 @@ func (yf *YelpFusion) SearchBusinessesByPhoneResponse(ctx context.Context, phone
 	if strings.TrimSpace(phoneNumber) == "" {
 		return nil, errors.New("phone number is required")
 	}
-	urlStr := fmt.Sprintf("%s%s?phone=%s", baseURL, phoneSearch, url.QueryEscape(phoneNumber))
+	urlStr := fmt.Sprintf("%s%s?phone=%s", yf.baseURL, phoneSearch, url.QueryEscape(phoneNumber))
 	req, err := yf.getRequest(ctx, "GET", urlStr)
 	if err != nil {
 		return nil, err
 	}
-	return yf.client.Do(req)
+	return yf.do(ctx, req)
 }
 
 // SearchBusinessReviews - Query for reviews for a particular business
@@ -201,7 +242,7 @@ func (yf *YelpFusion) SearchBusinessReviewsWithLocale(ctx context.Context, busID
 		return nil, err
 	}
 	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusMovedPermanently {
-		return nil, errors.New(resp.Status)
+		return nil, newYelpAPIError(resp)
 	}
 	defer resp.Body.Close()
 	var rd *ReviewsData
@@ -212,6 +253,89 @@ func (yf *YelpFusion) SearchBusinessReviewsWithLocale(ctx context.Context, busID
 	return rd, nil
 }
 
+// SearchTransactions - Use the Transaction Search route for the given transaction type with the given
+// TransactionSearchParams options
+// returns the parsed BusinessSearchData object
+func (yf *YelpFusion) SearchTransactions(transactionType string, ts *TransactionSearchParams) (*BusinessSearchData, error) {
+	return yf.SearchTransactionsWithContext(nil, transactionType, ts)
+}
+
+// SearchTransactionsWithContext - Use the Transaction Search route for the given transaction type with the given
+// TransactionSearchParams options and context
+// returns the parsed BusinessSearchData object
+func (yf *YelpFusion) SearchTransactionsWithContext(ctx context.Context, transactionType string, ts *TransactionSearchParams) (*BusinessSearchData, error) {
+	resp, err := yf.SearchTransactionsResponse(ctx, transactionType, ts)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, newYelpAPIError(resp)
+	}
+	defer resp.Body.Close()
+	var b *BusinessSearchData
+	decode := json.NewDecoder(resp.Body)
+	if err := decode.Decode(&b); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// SearchTransactionsResponse - Use the Transaction Search route for the given transaction type with the given
+// TransactionSearchParams options
+// returns the Response from the request
+func (yf *YelpFusion) SearchTransactionsResponse(ctx context.Context, transactionType string, ts *TransactionSearchParams) (*http.Response, error) {
+	params, err := ts.Params()
+	if err != nil {
+		return nil, err
+	}
+	urlStr := fmt.Sprintf("%s%s/%s/search?%s", yf.baseURL, transactionsEP, transactionType, params)
+	req, err := yf.getRequest(ctx, "GET", urlStr)
+	if err != nil {
+		return nil, err
+	}
+	return yf.do(ctx, req)
+}
+
+// MatchBusiness - Use the Business Match route with the given BusinessMatchParams options
+// returns the parsed BusinessMatchData object
+func (yf *YelpFusion) MatchBusiness(bm *BusinessMatchParams) (*BusinessMatchData, error) {
+	return yf.MatchBusinessWithContext(nil, bm)
+}
+
+// MatchBusinessWithContext - Use the Business Match route with the given BusinessMatchParams options and context
+// returns the parsed BusinessMatchData object
+func (yf *YelpFusion) MatchBusinessWithContext(ctx context.Context, bm *BusinessMatchParams) (*BusinessMatchData, error) {
+	resp, err := yf.MatchBusinessResponse(ctx, bm)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, newYelpAPIError(resp)
+	}
+	defer resp.Body.Close()
+	var b *BusinessMatchData
+	decode := json.NewDecoder(resp.Body)
+	if err := decode.Decode(&b); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// MatchBusinessResponse - Use the Business Match route with the given BusinessMatchParams options
+// returns the Response from the request
+func (yf *YelpFusion) MatchBusinessResponse(ctx context.Context, bm *BusinessMatchParams) (*http.Response, error) {
+	params, err := bm.Params()
+	if err != nil {
+		return nil, err
+	}
+	urlStr := fmt.Sprintf("%s%s?%s", yf.baseURL, busMatches, params)
+	req, err := yf.getRequest(ctx, "GET", urlStr)
+	if err != nil {
+		return nil, err
+	}
+	return yf.do(ctx, req)
+}
+
 // SearchBusinessReviewsWithLocaleResponse - Query for reviews for a particular business
 // The error field on the ReviewsData object will only be populated if an HTTP 301 status code is returned
 // In which case you can resend the request with the NewBusinessID from the error field on the ReviewsData object.
@@ -220,7 +344,7 @@ func (yf *YelpFusion) SearchBusinessReviewsWithLocale(ctx context.Context, busID
 // The locale defaults to en_US if left blank.
 // Returns the response from the request
 func (yf *YelpFusion) SearchBusinessReviewsWithLocaleResponse(ctx context.Context, busID, locale string) (*http.Response, error) {
-	urlStr := fmt.Sprintf("%s%s/%s%s", baseURL, busDetails, busID, reviewSearch)
+	urlStr := fmt.Sprintf("%s%s/%s%s", yf.baseURL, busDetails, busID, reviewSearch)
 	if strings.TrimSpace(locale) != "" {
 		urlStr = fmt.Sprintf("%s?locale=%s", urlStr, url.QueryEscape(locale))
 	}
@@ -228,5 +352,45 @@ func (yf *YelpFusion) SearchBusinessReviewsWithLocaleResponse(ctx context.Contex
 	if err != nil {
 		return nil, err
 	}
-	return yf.client.Do(req)
+	return yf.do(ctx, req)
+}
+
+// Autocomplete - Use the Autocomplete route with the given AutocompleteParams options
+// returns the parsed AutocompleteData object
+func (yf *YelpFusion) Autocomplete(ac *AutocompleteParams) (*AutocompleteData, error) {
+	return yf.AutocompleteWithContext(nil, ac)
+}
+
+// AutocompleteWithContext - Use the Autocomplete route with the given AutocompleteParams options and context
+// returns the parsed AutocompleteData object
+func (yf *YelpFusion) AutocompleteWithContext(ctx context.Context, ac *AutocompleteParams) (*AutocompleteData, error) {
+	resp, err := yf.AutocompleteResponse(ctx, ac)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, newYelpAPIError(resp)
+	}
+	defer resp.Body.Close()
+	var a *AutocompleteData
+	decode := json.NewDecoder(resp.Body)
+	if err := decode.Decode(&a); err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+// AutocompleteResponse - Use the Autocomplete route with the given AutocompleteParams options
+// returns the Response from the request
+func (yf *YelpFusion) AutocompleteResponse(ctx context.Context, ac *AutocompleteParams) (*http.Response, error) {
+	params, err := ac.Params()
+	if err != nil {
+		return nil, err
+	}
+	urlStr := fmt.Sprintf("%s%s?%s", yf.baseURL, autocompleteEP, params)
+	req, err := yf.getRequest(ctx, "GET", urlStr)
+	if err != nil {
+		return nil, err
+	}
+	return yf.do(ctx, req)
 }